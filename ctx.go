@@ -0,0 +1,94 @@
+package zagro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ctxListener wraps a context-aware callback with a unique ID for
+// identification, registered via OnCtx and delivered via EmitCtx.
+type ctxListener struct {
+	id       int
+	callback func(context.Context, *ZagroMessage)
+}
+
+// OnCtx registers a context-aware listener for the specified event. Such
+// listeners are only invoked by EmitCtx, never by Emit or EmitSync.
+// Returns a unique listener ID for later removal and an error if max
+// listeners exceeded.
+func (e *Zagro) OnCtx(event string, cb func(context.Context, *ZagroMessage)) (int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.maxListeners > 0 && len(e.ctxListeners[event]) >= e.maxListeners {
+		return 0, errors.New("max listeners exceeded for event: " + event)
+	}
+
+	e.nextID++
+	l := ctxListener{id: e.nextID, callback: cb}
+	e.ctxListeners[event] = append(e.ctxListeners[event], l)
+	return l.id, nil
+}
+
+// OffCtx removes a specific OnCtx listener from an event by its unique ID.
+func (e *Zagro) OffCtx(event string, id int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	listeners, ok := e.ctxListeners[event]
+	if !ok {
+		return
+	}
+	for i, l := range listeners {
+		if l.id == id {
+			e.ctxListeners[event] = append(listeners[:i], listeners[i+1:]...)
+			break
+		}
+	}
+	if len(e.ctxListeners[event]) == 0 {
+		delete(e.ctxListeners, event)
+	}
+}
+
+// EmitCtx delivers msg to every OnCtx listener registered for event, in
+// registration order, on the caller's goroutine. If ctx is cancelled before
+// or during delivery, remaining listeners are skipped and ctx.Err() is
+// returned. Each listener runs with a context derived from ctx, additionally
+// bounded by ZagroOptions.ListenerTimeout when set, and a panicking listener
+// is recovered into ZagroOptions.ErrorHandler instead of crashing the
+// emitter.
+func (e *Zagro) EmitCtx(ctx context.Context, event string, msg *ZagroMessage) error {
+	e.mu.Lock()
+	listeners := make([]ctxListener, len(e.ctxListeners[event]))
+	copy(listeners, e.ctxListeners[event])
+	e.mu.Unlock()
+
+	for _, l := range listeners {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		e.invokeCtxListener(ctx, event, l, msg)
+	}
+	return ctx.Err()
+}
+
+// invokeCtxListener runs a single OnCtx listener, applying the configured
+// ListenerTimeout and recovering a panic into the configured ErrorHandler.
+func (e *Zagro) invokeCtxListener(ctx context.Context, event string, l ctxListener, msg *ZagroMessage) {
+	listenerCtx := ctx
+	if e.listenerTimeout > 0 {
+		var cancel context.CancelFunc
+		listenerCtx, cancel = context.WithTimeout(ctx, e.listenerTimeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if e.errorHandler != nil {
+				e.errorHandler(event, fmt.Errorf("zagro: listener panic: %v", r))
+			}
+		}
+	}()
+
+	l.callback(listenerCtx, msg)
+}
@@ -0,0 +1,163 @@
+package zagro
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEmitCtxDeliversInOrder(t *testing.T) {
+	em := NewZagro()
+
+	var mu sync.Mutex
+	var order []string
+
+	_, _ = em.OnCtx("task", func(ctx context.Context, msg *ZagroMessage) {
+		mu.Lock()
+		order = append(order, "first")
+		mu.Unlock()
+	})
+	_, _ = em.OnCtx("task", func(ctx context.Context, msg *ZagroMessage) {
+		mu.Lock()
+		order = append(order, "second")
+		mu.Unlock()
+	})
+
+	if err := em.EmitCtx(context.Background(), "task", &ZagroMessage{}); err != nil {
+		t.Fatalf("EmitCtx returned error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"first", "second"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %q, want %q", i, order[i], want[i])
+		}
+	}
+}
+
+func TestEmitCtxSkipsRemainingOnCancel(t *testing.T) {
+	em := NewZagro()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var secondCalled bool
+	_, _ = em.OnCtx("task", func(ctx context.Context, msg *ZagroMessage) {
+		cancel()
+	})
+	_, _ = em.OnCtx("task", func(ctx context.Context, msg *ZagroMessage) {
+		secondCalled = true
+	})
+
+	err := em.EmitCtx(ctx, "task", &ZagroMessage{})
+	if err == nil {
+		t.Fatal("expected EmitCtx to return an error after cancellation")
+	}
+	if secondCalled {
+		t.Error("listener registered after cancellation was invoked")
+	}
+}
+
+func TestEmitCtxListenerTimeout(t *testing.T) {
+	em := NewZagro(ZagroOptions{ListenerTimeout: 10 * time.Millisecond})
+
+	var deadlineExceeded bool
+	done := make(chan struct{})
+	_, _ = em.OnCtx("slow", func(ctx context.Context, msg *ZagroMessage) {
+		<-ctx.Done()
+		deadlineExceeded = ctx.Err() == context.DeadlineExceeded
+		close(done)
+	})
+
+	go em.EmitCtx(context.Background(), "slow", &ZagroMessage{})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listener context was never cancelled by ListenerTimeout")
+	}
+	if !deadlineExceeded {
+		t.Error("listener context error was not DeadlineExceeded")
+	}
+}
+
+func TestEmitCtxRecoversPanicIntoErrorHandler(t *testing.T) {
+	var mu sync.Mutex
+	var handledEvent string
+	var handledErr error
+
+	em := NewZagro(ZagroOptions{
+		ErrorHandler: func(event string, err error) {
+			mu.Lock()
+			handledEvent = event
+			handledErr = err
+			mu.Unlock()
+		},
+	})
+
+	secondCalled := false
+	_, _ = em.OnCtx("risky", func(ctx context.Context, msg *ZagroMessage) {
+		panic("boom")
+	})
+	_, _ = em.OnCtx("risky", func(ctx context.Context, msg *ZagroMessage) {
+		secondCalled = true
+	})
+
+	if err := em.EmitCtx(context.Background(), "risky", &ZagroMessage{}); err != nil {
+		t.Fatalf("EmitCtx returned error: %v", err)
+	}
+
+	if !secondCalled {
+		t.Error("listener after a panicking one was not invoked")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if handledEvent != "risky" {
+		t.Errorf("handledEvent = %q, want %q", handledEvent, "risky")
+	}
+	if handledErr == nil {
+		t.Error("ErrorHandler did not receive an error")
+	}
+}
+
+func TestOffCtxRemovesListener(t *testing.T) {
+	em := NewZagro()
+
+	called := false
+	id, _ := em.OnCtx("task", func(ctx context.Context, msg *ZagroMessage) {
+		called = true
+	})
+
+	em.OffCtx("task", id)
+	if err := em.EmitCtx(context.Background(), "task", &ZagroMessage{}); err != nil {
+		t.Fatalf("EmitCtx returned error: %v", err)
+	}
+
+	if called {
+		t.Error("listener removed by OffCtx was still invoked")
+	}
+}
+
+func TestRemoveAllClearsCtxListeners(t *testing.T) {
+	em := NewZagro()
+
+	called := false
+	_, _ = em.OnCtx("task", func(ctx context.Context, msg *ZagroMessage) {
+		called = true
+	})
+
+	em.RemoveAll("task")
+	if err := em.EmitCtx(context.Background(), "task", &ZagroMessage{}); err != nil {
+		t.Fatalf("EmitCtx returned error: %v", err)
+	}
+
+	if called {
+		t.Error("ctx listener survived RemoveAll on its event")
+	}
+}
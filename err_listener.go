@@ -0,0 +1,82 @@
+package zagro
+
+import "errors"
+
+// ZagroCallbackE defines the function signature for error-returning event
+// listeners registered via OnE. Returning a non-nil error lets a listener
+// refuse an event, e.g. when used as a validator.
+type ZagroCallbackE func(*ZagroMessage) error
+
+// eListener wraps an error-returning callback with a unique ID for
+// identification, registered via OnE and delivered via EmitE.
+type eListener struct {
+	id       int
+	callback ZagroCallbackE
+}
+
+// OnE registers an error-returning listener for the specified event. Such
+// listeners are only invoked by EmitE, never by Emit or EmitSync.
+// Returns a unique listener ID for later removal and an error if max
+// listeners exceeded.
+func (e *Zagro) OnE(event string, cb ZagroCallbackE) (int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.maxListeners > 0 && len(e.eListeners[event]) >= e.maxListeners {
+		return 0, errors.New("max listeners exceeded for event: " + event)
+	}
+
+	e.nextID++
+	l := eListener{id: e.nextID, callback: cb}
+	e.eListeners[event] = append(e.eListeners[event], l)
+	return l.id, nil
+}
+
+// OffE removes a specific OnE listener from an event by its unique ID.
+func (e *Zagro) OffE(event string, id int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	listeners, ok := e.eListeners[event]
+	if !ok {
+		return
+	}
+	for i, l := range listeners {
+		if l.id == id {
+			e.eListeners[event] = append(listeners[:i], listeners[i+1:]...)
+			break
+		}
+	}
+	if len(e.eListeners[event]) == 0 {
+		delete(e.eListeners, event)
+	}
+}
+
+// RemoveAllE removes all OnE listeners registered for the specified event.
+func (e *Zagro) RemoveAllE(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.eListeners, event)
+}
+
+// EmitE delivers msg to every OnE listener registered for event, in
+// registration order, on the caller's goroutine, and joins every error
+// they return via errors.Join. If the emitter was created with
+// ZagroOptions.StopOnError, delivery stops at the first listener that
+// returns an error.
+func (e *Zagro) EmitE(event string, msg *ZagroMessage) error {
+	e.mu.Lock()
+	listeners := make([]eListener, len(e.eListeners[event]))
+	copy(listeners, e.eListeners[event])
+	e.mu.Unlock()
+
+	var errs []error
+	for _, l := range listeners {
+		if err := l.callback(msg); err != nil {
+			errs = append(errs, err)
+			if e.stopOnError {
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,100 @@
+package zagro
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEmitEAggregatesErrors(t *testing.T) {
+	em := NewZagro()
+
+	errA := errors.New("listener a failed")
+	errB := errors.New("listener b failed")
+
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error { return errA })
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error { return nil })
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error { return errB })
+
+	err := em.EmitE("validate", &ZagroMessage{})
+	if err == nil {
+		t.Fatal("expected a joined error, got nil")
+	}
+	if !errors.Is(err, errA) {
+		t.Error("joined error does not wrap errA")
+	}
+	if !errors.Is(err, errB) {
+		t.Error("joined error does not wrap errB")
+	}
+}
+
+func TestEmitENoErrors(t *testing.T) {
+	em := NewZagro()
+
+	called := 0
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error {
+		called++
+		return nil
+	})
+
+	if err := em.EmitE("validate", &ZagroMessage{}); err != nil {
+		t.Fatalf("EmitE returned error: %v", err)
+	}
+	if called != 1 {
+		t.Errorf("called = %d, want 1", called)
+	}
+}
+
+func TestEmitEStopOnError(t *testing.T) {
+	em := NewZagro(ZagroOptions{StopOnError: true})
+
+	firstErr := errors.New("first listener failed")
+	secondCalled := false
+
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error { return firstErr })
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error {
+		secondCalled = true
+		return nil
+	})
+
+	err := em.EmitE("validate", &ZagroMessage{})
+	if !errors.Is(err, firstErr) {
+		t.Fatalf("expected error to wrap firstErr, got %v", err)
+	}
+	if secondCalled {
+		t.Error("listener after a failing one was invoked despite StopOnError")
+	}
+}
+
+func TestOffERemovesListener(t *testing.T) {
+	em := NewZagro()
+
+	called := false
+	id, _ := em.OnE("validate", func(msg *ZagroMessage) error {
+		called = true
+		return nil
+	})
+
+	em.OffE("validate", id)
+	if err := em.EmitE("validate", &ZagroMessage{}); err != nil {
+		t.Fatalf("EmitE returned error: %v", err)
+	}
+	if called {
+		t.Error("listener removed by OffE was still invoked")
+	}
+}
+
+func TestRemoveAllEClearsEvent(t *testing.T) {
+	em := NewZagro()
+
+	called := 0
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error { called++; return nil })
+	_, _ = em.OnE("validate", func(msg *ZagroMessage) error { called++; return nil })
+
+	em.RemoveAllE("validate")
+	if err := em.EmitE("validate", &ZagroMessage{}); err != nil {
+		t.Fatalf("EmitE returned error: %v", err)
+	}
+	if called != 0 {
+		t.Errorf("called = %d, want 0 after RemoveAllE", called)
+	}
+}
@@ -0,0 +1,70 @@
+package zagro
+
+import "sync"
+
+// cachedEvent holds an event name and its associated message as recorded
+// by an EventCache awaiting Flush.
+type cachedEvent struct {
+	event string
+	msg   *ZagroMessage
+}
+
+// EventCache buffers events against an underlying Zagro emitter without
+// delivering them, analogous to tendermint's go-events event cache. Callers
+// build up events during a transaction-like operation and either Flush them
+// atomically on success or Discard them on failure.
+type EventCache struct {
+	mu     sync.Mutex
+	zagro  *Zagro
+	events []cachedEvent
+}
+
+// NewEventCache creates an EventCache wrapping the given Zagro emitter.
+// capacity preallocates the internal buffer; 0 leaves it to grow naturally.
+func NewEventCache(z *Zagro, capacity int) *EventCache {
+	return &EventCache{
+		zagro:  z,
+		events: make([]cachedEvent, 0, capacity),
+	}
+}
+
+// FireEvent records the event and message pair without delivering it.
+// Safe for concurrent use by multiple producers.
+func (c *EventCache) FireEvent(event string, msg *ZagroMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, cachedEvent{event: event, msg: msg})
+}
+
+// Cache records the event and message pair without delivering it.
+// It is an alias for FireEvent matching the tendermint go-events naming.
+func (c *EventCache) Cache(event string, msg *ZagroMessage) {
+	c.FireEvent(event, msg)
+}
+
+// Flush replays all cached events through the underlying Zagro emitter, in
+// the order they were recorded, and clears the buffer.
+func (c *EventCache) Flush() {
+	c.mu.Lock()
+	events := c.events
+	c.events = nil
+	c.mu.Unlock()
+
+	for _, e := range events {
+		c.zagro.Emit(e.event, e.msg)
+	}
+}
+
+// Discard clears the buffer without delivering any cached events.
+func (c *EventCache) Discard() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = nil
+}
+
+// Len returns the number of events currently buffered.
+func (c *EventCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.events)
+}
@@ -0,0 +1,98 @@
+package zagro
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestEventCacheFlush(t *testing.T) {
+	em := NewZagro()
+
+	var mu sync.Mutex
+	var order []string
+
+	_, _ = em.On("a", func(msg *ZagroMessage) {
+		mu.Lock()
+		order = append(order, "a")
+		mu.Unlock()
+	})
+	_, _ = em.On("b", func(msg *ZagroMessage) {
+		mu.Lock()
+		order = append(order, "b")
+		mu.Unlock()
+	})
+
+	cache := NewEventCache(em, 4)
+	cache.FireEvent("a", &ZagroMessage{Data: 1})
+	cache.Cache("b", &ZagroMessage{Data: 2})
+	cache.FireEvent("a", &ZagroMessage{Data: 3})
+
+	if c := cache.Len(); c != 3 {
+		t.Fatalf("Len() = %d, want 3", c)
+	}
+
+	mu.Lock()
+	if len(order) != 0 {
+		t.Fatalf("events delivered before Flush: %v", order)
+	}
+	mu.Unlock()
+
+	cache.Flush()
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"a", "b", "a"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %q, want %q", i, order[i], want[i])
+		}
+	}
+
+	if c := cache.Len(); c != 0 {
+		t.Errorf("Len() after Flush = %d, want 0", c)
+	}
+}
+
+func TestEventCacheDiscard(t *testing.T) {
+	em := NewZagro()
+
+	called := false
+	_, _ = em.On("a", func(msg *ZagroMessage) {
+		called = true
+	})
+
+	cache := NewEventCache(em, 0)
+	cache.FireEvent("a", &ZagroMessage{})
+	cache.Discard()
+
+	if c := cache.Len(); c != 0 {
+		t.Errorf("Len() after Discard = %d, want 0", c)
+	}
+
+	cache.Flush()
+	if called {
+		t.Error("listener called after Discard, want no delivery")
+	}
+}
+
+func TestEventCacheConcurrentProducers(t *testing.T) {
+	em := NewZagro()
+	cache := NewEventCache(em, 100)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache.FireEvent("concurrent", &ZagroMessage{})
+		}()
+	}
+	wg.Wait()
+
+	if c := cache.Len(); c != 50 {
+		t.Errorf("Len() = %d, want 50", c)
+	}
+}
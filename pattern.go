@@ -0,0 +1,130 @@
+package zagro
+
+import (
+	"errors"
+	"strings"
+)
+
+// patternListener wraps a glob-style pattern callback with its segments
+// precompiled, so Emit only splits the emitted event name, not the pattern.
+type patternListener struct {
+	id       int
+	pattern  string
+	segments []string
+	callback ZagroCallback
+}
+
+// anyListener wraps a callback registered via OnAny, which observes every
+// emitted event regardless of name.
+type anyListener struct {
+	id       int
+	callback func(event string, msg *ZagroMessage)
+}
+
+// OnPattern registers a listener for a hierarchical, dot-separated event
+// pattern such as "user.created", "order.*", or "**". A single "*" segment
+// matches exactly one segment; "**" matches zero or more segments. Emit
+// walks every registered pattern in addition to exact-match listeners, so
+// pattern listeners are kept separate from the exact-match map to keep
+// exact-match dispatch O(1). Returns a unique listener ID for later removal
+// via OffPattern or RemoveAll, and an error if max listeners exceeded for
+// that exact pattern string.
+func (e *Zagro) OnPattern(pattern string, cb ZagroCallback) (int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.maxListeners > 0 && len(e.patterns[pattern]) >= e.maxListeners {
+		return 0, errors.New("max listeners exceeded for event: " + pattern)
+	}
+
+	e.nextID++
+	pl := patternListener{
+		id:       e.nextID,
+		pattern:  pattern,
+		segments: splitEventName(pattern),
+		callback: cb,
+	}
+	e.patterns[pattern] = append(e.patterns[pattern], pl)
+	return pl.id, nil
+}
+
+// OnAny registers a listener invoked for every emitted event, regardless
+// of name, receiving the event name alongside its ZagroMessage. Returns a
+// unique listener ID for later removal via OffAny, and an error if max
+// listeners exceeded.
+func (e *Zagro) OnAny(cb func(event string, msg *ZagroMessage)) (int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.maxListeners > 0 && len(e.anyListeners) >= e.maxListeners {
+		return 0, errors.New("max listeners exceeded for OnAny")
+	}
+
+	e.nextID++
+	al := anyListener{id: e.nextID, callback: cb}
+	e.anyListeners = append(e.anyListeners, al)
+	return al.id, nil
+}
+
+// OffPattern removes a specific pattern listener registered via OnPattern,
+// identified by its pattern string and unique ID.
+func (e *Zagro) OffPattern(pattern string, id int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	listeners, ok := e.patterns[pattern]
+	if !ok {
+		return
+	}
+	for i, pl := range listeners {
+		if pl.id == id {
+			e.patterns[pattern] = append(listeners[:i], listeners[i+1:]...)
+			break
+		}
+	}
+	if len(e.patterns[pattern]) == 0 {
+		delete(e.patterns, pattern)
+	}
+}
+
+// OffAny removes a specific listener registered via OnAny, by its unique ID.
+func (e *Zagro) OffAny(id int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, al := range e.anyListeners {
+		if al.id == id {
+			e.anyListeners = append(e.anyListeners[:i], e.anyListeners[i+1:]...)
+			return
+		}
+	}
+}
+
+// splitEventName splits a dot-separated event or pattern name into segments.
+func splitEventName(name string) []string {
+	return strings.Split(name, ".")
+}
+
+// matchSegments reports whether event matches the glob pattern described by
+// segments. "*" matches exactly one segment; "**" matches zero or more.
+func matchSegments(pattern, event []string) bool {
+	if len(pattern) == 0 {
+		return len(event) == 0
+	}
+
+	if pattern[0] == "**" {
+		if matchSegments(pattern[1:], event) {
+			return true
+		}
+		if len(event) == 0 {
+			return false
+		}
+		return matchSegments(pattern, event[1:])
+	}
+
+	if len(event) == 0 {
+		return false
+	}
+	if pattern[0] != "*" && pattern[0] != event[0] {
+		return false
+	}
+	return matchSegments(pattern[1:], event[1:])
+}
@@ -0,0 +1,190 @@
+package zagro
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestOnPatternSingleWildcard(t *testing.T) {
+	em := NewZagro()
+
+	var mu sync.Mutex
+	var matched []string
+
+	_, err := em.OnPattern("order.*", func(msg *ZagroMessage) {
+		mu.Lock()
+		matched = append(matched, msg.Data.(string))
+		mu.Unlock()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	em.Emit("order.created", &ZagroMessage{Data: "created"})
+	em.Emit("order.shipped", &ZagroMessage{Data: "shipped"})
+	em.Emit("order.line.created", &ZagroMessage{Data: "should not match"})
+	em.Emit("user.created", &ZagroMessage{Data: "should not match"})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(matched) != 2 {
+		t.Fatalf("matched = %v, want 2 events", matched)
+	}
+}
+
+func TestOnPatternDoubleWildcard(t *testing.T) {
+	em := NewZagro()
+
+	count := 0
+	var mu sync.Mutex
+	_, err := em.OnPattern("user.**", func(msg *ZagroMessage) {
+		mu.Lock()
+		count++
+		mu.Unlock()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	em.Emit("user.created", &ZagroMessage{})
+	em.Emit("user.profile.updated", &ZagroMessage{})
+	em.Emit("user", &ZagroMessage{})
+	em.Emit("order.created", &ZagroMessage{})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if count != 3 {
+		t.Errorf("count = %d, want 3", count)
+	}
+}
+
+func TestOnAnyObservesEveryEvent(t *testing.T) {
+	em := NewZagro()
+
+	var mu sync.Mutex
+	var seen []string
+
+	_, err := em.OnAny(func(event string, msg *ZagroMessage) {
+		mu.Lock()
+		seen = append(seen, event)
+		mu.Unlock()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	em.Emit("user.created", &ZagroMessage{})
+	em.Emit("order.shipped", &ZagroMessage{})
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"user.created", "order.shipped"}
+	if len(seen) != len(want) {
+		t.Fatalf("seen = %v, want %v", seen, want)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Errorf("seen[%d] = %q, want %q", i, seen[i], want[i])
+		}
+	}
+}
+
+func TestExactMatchUnaffectedByPatterns(t *testing.T) {
+	em := NewZagro()
+
+	exactCalled := 0
+	_, _ = em.On("order.created", func(msg *ZagroMessage) {
+		exactCalled++
+	})
+	_, _ = em.OnPattern("order.*", func(msg *ZagroMessage) {})
+
+	em.Emit("order.created", &ZagroMessage{})
+
+	if exactCalled != 1 {
+		t.Errorf("exactCalled = %d, want 1", exactCalled)
+	}
+}
+
+func TestOffPatternRemovesListener(t *testing.T) {
+	em := NewZagro()
+
+	called := false
+	id, err := em.OnPattern("order.*", func(msg *ZagroMessage) {
+		called = true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	em.OffPattern("order.*", id)
+	em.Emit("order.created", &ZagroMessage{})
+
+	if called {
+		t.Error("listener removed by OffPattern was still invoked")
+	}
+}
+
+func TestOffAnyRemovesListener(t *testing.T) {
+	em := NewZagro()
+
+	called := false
+	id, err := em.OnAny(func(event string, msg *ZagroMessage) {
+		called = true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	em.OffAny(id)
+	em.Emit("order.created", &ZagroMessage{})
+
+	if called {
+		t.Error("listener removed by OffAny was still invoked")
+	}
+}
+
+func TestRemoveAllClearsMatchingPattern(t *testing.T) {
+	em := NewZagro()
+
+	called := false
+	_, _ = em.OnPattern("order.*", func(msg *ZagroMessage) {
+		called = true
+	})
+
+	em.RemoveAll("order.*")
+	em.Emit("order.created", &ZagroMessage{})
+
+	if called {
+		t.Error("pattern listener survived RemoveAll on its pattern string")
+	}
+}
+
+func TestOnPatternEnforcesMaxListeners(t *testing.T) {
+	em := NewZagro(ZagroOptions{MaxListeners: 2})
+
+	if _, err := em.OnPattern("order.*", func(msg *ZagroMessage) {}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := em.OnPattern("order.*", func(msg *ZagroMessage) {}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := em.OnPattern("order.*", func(msg *ZagroMessage) {}); err == nil {
+		t.Error("expected error for max listeners exceeded, got nil")
+	}
+
+	// A distinct pattern string has its own budget.
+	if _, err := em.OnPattern("user.*", func(msg *ZagroMessage) {}); err != nil {
+		t.Errorf("OnPattern for a different pattern should not be limited by order.*'s count: %v", err)
+	}
+}
+
+func TestOnAnyEnforcesMaxListeners(t *testing.T) {
+	em := NewZagro(ZagroOptions{MaxListeners: 1})
+
+	if _, err := em.OnAny(func(event string, msg *ZagroMessage) {}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := em.OnAny(func(event string, msg *ZagroMessage) {}); err == nil {
+		t.Error("expected error for max listeners exceeded, got nil")
+	}
+}
@@ -0,0 +1,106 @@
+package zagro
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestOnWithPriorityOrdersDescending(t *testing.T) {
+	em := NewZagro()
+
+	var mu sync.Mutex
+	var order []string
+
+	record := func(name string) ZagroCallback {
+		return func(msg *ZagroMessage) {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+		}
+	}
+
+	_, _ = em.On("task", record("default-a"))
+	_, _ = em.OnWithPriority("task", 10, record("high"))
+	_, _ = em.On("task", record("default-b"))
+	_, _ = em.OnWithPriority("task", -5, record("low"))
+
+	em.Emit("task", &ZagroMessage{})
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"high", "default-a", "default-b", "low"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %q, want %q", i, order[i], want[i])
+		}
+	}
+}
+
+func TestPrependInsertsAtHead(t *testing.T) {
+	em := NewZagro()
+
+	var mu sync.Mutex
+	var order []string
+
+	record := func(name string) ZagroCallback {
+		return func(msg *ZagroMessage) {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+		}
+	}
+
+	_, _ = em.On("task", record("first"))
+	_, _ = em.On("task", record("second"))
+	_, _ = em.Prepend("task", record("prepended"))
+
+	em.Emit("task", &ZagroMessage{})
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"prepended", "first", "second"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %q, want %q", i, order[i], want[i])
+		}
+	}
+}
+
+func TestPrependOnceFiresOnceAtHead(t *testing.T) {
+	em := NewZagro()
+
+	var mu sync.Mutex
+	var order []string
+
+	_, _ = em.On("task", func(msg *ZagroMessage) {
+		mu.Lock()
+		order = append(order, "regular")
+		mu.Unlock()
+	})
+	_, _ = em.PrependOnce("task", func(msg *ZagroMessage) {
+		mu.Lock()
+		order = append(order, "once")
+		mu.Unlock()
+	})
+
+	em.Emit("task", &ZagroMessage{})
+	em.Emit("task", &ZagroMessage{})
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"once", "regular", "regular"}
+	if len(order) != len(want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("order[%d] = %q, want %q", i, order[i], want[i])
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package zagro
 
 import (
+	"context"
 	"errors"
+	"sort"
 	"sync"
+	"time"
 )
 
 // ZagroMessage represents the message passed to event listeners.
@@ -15,9 +18,11 @@ type ZagroMessage struct {
 // It receives a pointer to a ZagroMessage.
 type ZagroCallback func(*ZagroMessage)
 
-// listener wraps a callback with a unique ID for identification.
+// listener wraps a callback with a unique ID for identification and a
+// priority used to order delivery within Emit.
 type listener struct {
 	id       int
+	priority int
 	callback ZagroCallback
 }
 
@@ -26,6 +31,32 @@ type ZagroOptions struct {
 	// MaxListeners limits how many listeners can be registered per event.
 	// 0 means unlimited listeners.
 	MaxListeners int
+
+	// Async switches Emit to dispatch each event through a dedicated
+	// buffered channel and worker goroutine instead of running listeners
+	// synchronously on the caller's goroutine.
+	Async bool
+
+	// BufferSize sets the capacity of each event's worker channel when
+	// Async is enabled. 0 falls back to a capacity of 1.
+	BufferSize int
+
+	// OnOverflow, when set, is called instead of blocking when Async is
+	// enabled and an event's worker channel is full.
+	OnOverflow func(event string, msg *ZagroMessage)
+
+	// ListenerTimeout, when set, bounds each OnCtx listener invocation with
+	// a deadline derived from the context passed to EmitCtx.
+	ListenerTimeout time.Duration
+
+	// ErrorHandler, when set, receives the event name and a recovered
+	// listener panic (wrapped as an error) instead of letting it crash the
+	// emitter. Used by EmitCtx.
+	ErrorHandler func(event string, err error)
+
+	// StopOnError makes EmitE stop calling further listeners for an event
+	// as soon as one of them returns an error.
+	StopOnError bool
 }
 
 // Zagro is a concurrency-safe event emitter inspired by JavaScript's EventEmitter.
@@ -35,23 +66,78 @@ type Zagro struct {
 	events       map[string][]listener
 	nextID       int
 	maxListeners int
+
+	async      bool
+	bufferSize int
+	onOverflow func(event string, msg *ZagroMessage)
+	workers    map[string]chan *ZagroMessage
+	workerWG   sync.WaitGroup
+	closed     bool
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+
+	patterns     map[string][]patternListener
+	anyListeners []anyListener
+
+	ctxListeners    map[string][]ctxListener
+	listenerTimeout time.Duration
+	errorHandler    func(event string, err error)
+
+	eListeners  map[string][]eListener
+	stopOnError bool
 }
 
 // NewZagro creates a new Zagro event emitter.
-// Accepts optional ZagroOptions such as MaxListeners to limit listeners per event.
+// Accepts optional ZagroOptions such as MaxListeners to limit listeners per event,
+// or Async/BufferSize/OnOverflow to enable buffered, per-event worker dispatch.
 func NewZagro(opts ...ZagroOptions) *Zagro {
 	em := &Zagro{
-		events: make(map[string][]listener),
+		events:       make(map[string][]listener),
+		patterns:     make(map[string][]patternListener),
+		ctxListeners: make(map[string][]ctxListener),
+		eListeners:   make(map[string][]eListener),
 	}
 	if len(opts) > 0 {
 		em.maxListeners = opts[0].MaxListeners
+		em.async = opts[0].Async
+		em.bufferSize = opts[0].BufferSize
+		em.onOverflow = opts[0].OnOverflow
+		em.listenerTimeout = opts[0].ListenerTimeout
+		em.errorHandler = opts[0].ErrorHandler
+		em.stopOnError = opts[0].StopOnError
+	}
+	if em.async {
+		em.workers = make(map[string]chan *ZagroMessage)
+		em.stopCh = make(chan struct{})
 	}
 	return em
 }
 
-// On registers a new listener callback for the specified event.
+// On registers a new listener callback for the specified event, at the
+// default priority of 0.
 // Returns a unique listener ID for later removal and an error if max listeners exceeded.
 func (e *Zagro) On(event string, cb ZagroCallback) (int, error) {
+	return e.addListener(event, 0, cb, false)
+}
+
+// OnWithPriority registers a listener for the specified event with an
+// explicit priority. Emit delivers listeners in descending priority order,
+// preserving insertion order among listeners that share a priority.
+func (e *Zagro) OnWithPriority(event string, priority int, cb ZagroCallback) (int, error) {
+	return e.addListener(event, priority, cb, false)
+}
+
+// Prepend registers a listener for the specified event at the default
+// priority of 0, inserted ahead of any already-registered same-priority
+// listeners for that event.
+func (e *Zagro) Prepend(event string, cb ZagroCallback) (int, error) {
+	return e.addListener(event, 0, cb, true)
+}
+
+// addListener is the shared implementation behind On, OnWithPriority, and
+// Prepend: it enforces MaxListeners and inserts the new listener either at
+// the tail (append order) or the head (prepend order) of the event's slice.
+func (e *Zagro) addListener(event string, priority int, cb ZagroCallback, prepend bool) (int, error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -60,8 +146,12 @@ func (e *Zagro) On(event string, cb ZagroCallback) (int, error) {
 	}
 
 	e.nextID++
-	l := listener{id: e.nextID, callback: cb}
-	e.events[event] = append(e.events[event], l)
+	l := listener{id: e.nextID, priority: priority, callback: cb}
+	if prepend {
+		e.events[event] = append([]listener{l}, e.events[event]...)
+	} else {
+		e.events[event] = append(e.events[event], l)
+	}
 	return l.id, nil
 }
 
@@ -80,19 +170,189 @@ func (e *Zagro) Once(event string, cb ZagroCallback) (int, error) {
 	return id, err
 }
 
-// Emit triggers all listeners registered for the given event,
-// passing the provided ZagroMessage to each callback.
+// PrependOnce registers a one-time listener for the event, inserted ahead
+// of any already-registered same-priority listeners for that event.
+// The listener is automatically removed after the first invocation.
+func (e *Zagro) PrependOnce(event string, cb ZagroCallback) (int, error) {
+	var id int
+	var err error
+
+	wrapper := func(msg *ZagroMessage) {
+		e.Off(event, id)
+		cb(msg)
+	}
+
+	id, err = e.Prepend(event, wrapper)
+	return id, err
+}
+
+// Emit triggers all listeners registered for the given event, passing the
+// provided ZagroMessage to each callback. If the emitter was created with
+// ZagroOptions.Async, dispatch happens on the event's worker goroutine
+// instead of the caller's; use EmitSync to force synchronous delivery.
 func (e *Zagro) Emit(event string, msg *ZagroMessage) {
+	if !e.async {
+		e.dispatch(event, msg)
+		return
+	}
+	e.sendAsync(event, msg)
+}
+
+// sendAsync enqueues msg onto event's worker channel, creating the worker
+// on first use. The enqueue happens while holding e.mu so that Close cannot
+// observe "not closed" and then close(ch) out from under an in-flight send:
+// Close also takes e.mu before closing any channel, so the two can never
+// interleave. If the emitter is already closed, msg is dropped.
+func (e *Zagro) sendAsync(event string, msg *ZagroMessage) {
 	e.mu.Lock()
-	callbacks := make([]ZagroCallback, 0, len(e.events[event]))
-	for _, l := range e.events[event] {
+
+	if e.closed {
+		e.mu.Unlock()
+		return
+	}
+
+	ch, ok := e.workers[event]
+	if !ok {
+		bufSize := e.bufferSize
+		if bufSize <= 0 {
+			bufSize = 1
+		}
+		ch = make(chan *ZagroMessage, bufSize)
+		e.workers[event] = ch
+
+		e.workerWG.Add(1)
+		go e.runWorker(event, ch)
+	}
+
+	select {
+	case ch <- msg:
+		e.mu.Unlock()
+	default:
+		e.mu.Unlock()
+		if e.onOverflow != nil {
+			e.onOverflow(event, msg)
+		}
+	}
+}
+
+// EmitSync triggers all listeners registered for the given event on the
+// caller's goroutine, bypassing async mode. It is the escape hatch for
+// callers that need the pre-Async synchronous behavior even when the
+// emitter was configured with ZagroOptions.Async.
+func (e *Zagro) EmitSync(event string, msg *ZagroMessage) {
+	e.dispatch(event, msg)
+}
+
+// dispatch runs every listener currently registered for event with msg,
+// on whatever goroutine calls it. This includes exact-match listeners,
+// pattern listeners whose glob matches event, and OnAny listeners.
+func (e *Zagro) dispatch(event string, msg *ZagroMessage) {
+	e.mu.Lock()
+	listeners := make([]listener, len(e.events[event]))
+	copy(listeners, e.events[event])
+	sort.SliceStable(listeners, func(i, j int) bool {
+		return listeners[i].priority > listeners[j].priority
+	})
+
+	callbacks := make([]ZagroCallback, 0, len(listeners))
+	for _, l := range listeners {
 		callbacks = append(callbacks, l.callback)
 	}
+
+	if len(e.patterns) > 0 {
+		segments := splitEventName(event)
+		for _, plList := range e.patterns {
+			for _, pl := range plList {
+				if matchSegments(pl.segments, segments) {
+					callbacks = append(callbacks, pl.callback)
+				}
+			}
+		}
+	}
+
+	anyCallbacks := make([]func(string, *ZagroMessage), 0, len(e.anyListeners))
+	for _, al := range e.anyListeners {
+		anyCallbacks = append(anyCallbacks, al.callback)
+	}
 	e.mu.Unlock()
 
 	for _, cb := range callbacks {
 		cb(msg)
 	}
+	for _, cb := range anyCallbacks {
+		cb(event, msg)
+	}
+}
+
+// runWorker dispatches queued messages for event until ch is closed or
+// e.stopCh fires, whichever happens first. stopCh lets Shutdown cut a drain
+// short instead of running every buffered message to completion.
+func (e *Zagro) runWorker(event string, ch chan *ZagroMessage) {
+	defer e.workerWG.Done()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			e.dispatch(event, msg)
+		case <-e.stopCh:
+			return
+		}
+	}
+}
+
+// Close stops accepting new async events, drains every worker's pending
+// messages, and blocks until all worker goroutines have exited. It is a
+// no-op when the emitter was not created with ZagroOptions.Async.
+func (e *Zagro) Close() {
+	e.mu.Lock()
+	if e.closed {
+		e.mu.Unlock()
+		return
+	}
+	e.closed = true
+	for _, ch := range e.workers {
+		close(ch)
+	}
+	e.mu.Unlock()
+
+	e.workerWG.Wait()
+}
+
+// Shutdown behaves like Close, but if ctx is cancelled before the workers
+// finish draining, it cancels the drain: each worker stops picking up
+// further queued messages and exits immediately instead of running its
+// backlog to completion. Shutdown still blocks until every worker has
+// actually exited before returning, so workers are always joined; it
+// returns ctx.Err() when cancellation is what ended the wait, nil if the
+// drain finished naturally first.
+func (e *Zagro) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		e.Close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		e.cancelPending()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// cancelPending signals every worker goroutine to stop picking up further
+// queued messages and exit. Safe to call multiple times or concurrently.
+func (e *Zagro) cancelPending() {
+	if e.stopCh == nil {
+		return
+	}
+	e.stopOnce.Do(func() {
+		close(e.stopCh)
+	})
 }
 
 // Off removes a specific listener from an event by its unique ID.
@@ -114,11 +374,15 @@ func (e *Zagro) Off(event string, id int) {
 	}
 }
 
-// RemoveAll removes all listeners registered for the specified event.
+// RemoveAll removes all listeners registered for the specified event,
+// including any OnPattern listeners registered under that exact pattern
+// string and any OnCtx listeners registered for that event.
 func (e *Zagro) RemoveAll(event string) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 	delete(e.events, event)
+	delete(e.ctxListeners, event)
+	delete(e.patterns, event)
 }
 
 // Count returns the number of listeners registered for a specific event.
@@ -1,9 +1,11 @@
 package zagro
 
 import (
+	"context"
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestEventEmitter(t *testing.T) {
@@ -115,3 +117,169 @@ func TestMultipleEvents(t *testing.T) {
 		}
 	}
 }
+
+func TestAsyncEmitDeliversOnWorker(t *testing.T) {
+	em := NewZagro(ZagroOptions{Async: true, BufferSize: 4})
+	defer em.Close()
+
+	release := make(chan struct{})
+	done := make(chan struct{})
+
+	_, _ = em.On("work", func(msg *ZagroMessage) {
+		<-release
+		close(done)
+	})
+
+	em.Emit("work", &ZagroMessage{Data: 1}) // must return without waiting on the listener
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listener was not invoked")
+	}
+}
+
+func TestAsyncEmitOverflowCallsOnOverflow(t *testing.T) {
+	block := make(chan struct{})
+	overflowCh := make(chan string, 1)
+
+	em := NewZagro(ZagroOptions{
+		Async:      true,
+		BufferSize: 1,
+		OnOverflow: func(event string, msg *ZagroMessage) {
+			overflowCh <- event
+		},
+	})
+	defer em.Close()
+
+	started := make(chan struct{})
+	var once sync.Once
+	_, _ = em.On("busy", func(msg *ZagroMessage) {
+		once.Do(func() { close(started) })
+		<-block
+	})
+
+	em.Emit("busy", &ZagroMessage{Data: 1}) // picked up by the worker immediately
+	<-started
+	em.Emit("busy", &ZagroMessage{Data: 2}) // fills the buffered channel
+	em.Emit("busy", &ZagroMessage{Data: 3}) // should overflow
+
+	select {
+	case event := <-overflowCh:
+		if event != "busy" {
+			t.Errorf("OnOverflow event = %q, want %q", event, "busy")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("OnOverflow was not called")
+	}
+
+	close(block)
+}
+
+func TestEmitSyncBypassesAsync(t *testing.T) {
+	em := NewZagro(ZagroOptions{Async: true, BufferSize: 4})
+	defer em.Close()
+
+	called := false
+	_, _ = em.On("sync-event", func(msg *ZagroMessage) {
+		called = true
+	})
+
+	em.EmitSync("sync-event", &ZagroMessage{})
+	if !called {
+		t.Error("EmitSync did not invoke listener synchronously")
+	}
+}
+
+func TestCloseDrainsPendingEvents(t *testing.T) {
+	em := NewZagro(ZagroOptions{Async: true, BufferSize: 4})
+
+	var mu sync.Mutex
+	delivered := 0
+	_, _ = em.On("drain", func(msg *ZagroMessage) {
+		mu.Lock()
+		delivered++
+		mu.Unlock()
+	})
+
+	for i := 0; i < 3; i++ {
+		em.Emit("drain", &ZagroMessage{Data: i})
+	}
+
+	em.Close()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if delivered != 3 {
+		t.Errorf("delivered = %d, want 3", delivered)
+	}
+}
+
+func TestAsyncEmitConcurrentWithClose(t *testing.T) {
+	em := NewZagro(ZagroOptions{Async: true, BufferSize: 8})
+
+	_, _ = em.On("stress", func(msg *ZagroMessage) {})
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					em.Emit("stress", &ZagroMessage{Data: 1})
+				}
+			}
+		}()
+	}
+
+	// Give the producers a head start so Close races against in-flight
+	// Emit calls instead of running before any of them start.
+	time.Sleep(time.Millisecond)
+	em.Close()
+	close(stop)
+	wg.Wait()
+}
+
+func TestShutdownCancelsDrain(t *testing.T) {
+	em := NewZagro(ZagroOptions{Async: true, BufferSize: 100})
+
+	var mu sync.Mutex
+	delivered := 0
+	_, _ = em.On("slow", func(msg *ZagroMessage) {
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		delivered++
+		mu.Unlock()
+	})
+
+	for i := 0; i < 50; i++ {
+		em.Emit("slow", &ZagroMessage{Data: i})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := em.Shutdown(ctx)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected Shutdown to return ctx.Err() after cancellation")
+	}
+	if elapsed > time.Second {
+		t.Fatalf("Shutdown took %v, want it to return promptly after ctx cancellation", elapsed)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if delivered >= 50 {
+		t.Errorf("delivered = %d, want the cancelled drain to have dropped some of the backlog", delivered)
+	}
+}